@@ -0,0 +1,169 @@
+// Copyright 2015 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+// disasm is a pretty-printer for EVM bytecode.
+// disasm은 EVM 바이트코드를 위한 프리티 프린터입니다.
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/asm"
+)
+
+var (
+	formatFlag        = flag.String("format", "text", "output format: text, json or jsonl")
+	srcmapFlag        = flag.String("srcmap", "", "Solidity compressed source map to interleave with the disassembly")
+	sourcesFlag       = flag.String("sources", "", "directory holding the Solidity sources referenced by -srcmap")
+	stripMetadataFlag = flag.Bool("strip-metadata", false, "detect solc's CBOR metadata trailer and leave it out instead of disassembling it as bogus opcodes; the text format also prints a summary of it")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <file>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0)); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
+
+// run disassembles the contract code found in file and prints it to stdout
+// in the format selected by -format.
+// run은 file에서 찾은 컨트랙트 코드를 분해하여 -format으로 선택된 형식으로
+// stdout에 출력합니다.
+func run(file string) error {
+	in, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	script, err := hex.DecodeString(strings.TrimSpace(string(in)))
+	if err != nil {
+		return fmt.Errorf("invalid hex: %v", err)
+	}
+
+	if *srcmapFlag != "" {
+		return runWithSourceMap(script)
+	}
+
+	var opts []asm.Option
+	if *stripMetadataFlag {
+		opts = append(opts, asm.AnnotateMetadata())
+	}
+
+	switch *formatFlag {
+	case "text":
+		return asm.PrintDisassembled(hex.EncodeToString(script), opts...)
+	case "json":
+		instrs, err := asm.DisassembleJSON(script, opts...)
+		if err != nil {
+			return err
+		}
+		return printJSON(instrs)
+	case "jsonl":
+		return asm.EncodeJSON(os.Stdout, script, opts...)
+	default:
+		return fmt.Errorf("unknown -format %q", *formatFlag)
+	}
+}
+
+// runWithSourceMap disassembles script and interleaves every instruction
+// with the Solidity source snippet it was compiled from, using the srcmap
+// and sources named by -srcmap and -sources.
+// runWithSourceMap은 script를 분해하고, -srcmap 및 -sources로 지정된
+// srcmap과 sources를 사용하여 각 명령어를 그 명령어가 컴파일된 Solidity
+// 소스 코드 조각과 함께 출력합니다.
+func runWithSourceMap(script []byte) error {
+	rawmap, err := ioutil.ReadFile(*srcmapFlag)
+	if err != nil {
+		return err
+	}
+	sources, err := loadSources(*sourcesFlag)
+	if err != nil {
+		return err
+	}
+
+	instrs, err := asm.DisassembleWithSourceMap(script, strings.TrimSpace(string(rawmap)), sources)
+	if err != nil {
+		return err
+	}
+	for _, in := range instrs {
+		if src, ok := snippet(sources, in); ok {
+			fmt.Printf("; %s\n", src)
+		}
+		if in.Arg != "" {
+			fmt.Printf("%06d: %s 0x%s\n", in.Pc, in.Op, in.Arg)
+		} else {
+			fmt.Printf("%06d: %s\n", in.Pc, in.Op)
+		}
+	}
+	return nil
+}
+
+// loadSources maps every *.sol file directly under dir to the index of its
+// entry in dir's listing, which is sorted by file name; directories and
+// other files are skipped but still take up an index. These indices stand
+// in for Solidity's srcmap file indices.
+// loadSources는 dir 바로 아래에 있는 모든 *.sol 파일을, 파일 이름 순으로
+// 정렬된 dir 목록에서 그 항목이 차지하는 인덱스에 매핑합니다; 디렉터리와
+// 다른 파일들은 건너뛰지만 인덱스는 여전히 하나씩 차지합니다. 이 인덱스들이
+// Solidity srcmap 파일 인덱스로 사용됩니다.
+func loadSources(dir string) (map[int]string, error) {
+	sources := make(map[int]string)
+	if dir == "" {
+		return sources, nil
+	}
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for i, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".sol") {
+			continue
+		}
+		sources[i] = filepath.Join(dir, e.Name())
+	}
+	return sources, nil
+}
+
+// snippet returns the source text that in.Offset/in.Length point to within
+// its source file, for interleaving with the disassembly.
+// snippet은 in.Offset/in.Length가 가리키는, 소스 파일 내의 소스 텍스트를
+// 분해 결과와 함께 출력하기 위해 반환합니다.
+func snippet(sources map[int]string, in asm.AnnotatedInstruction) (string, bool) {
+	if in.SourceFile == "" {
+		return "", false
+	}
+	content, err := ioutil.ReadFile(in.SourceFile)
+	if err != nil || in.Offset+in.Length > len(content) {
+		return "", false
+	}
+	text := string(content[in.Offset : in.Offset+in.Length])
+	return strings.ReplaceAll(strings.TrimSpace(text), "\n", " "), true
+}
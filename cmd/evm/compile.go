@@ -0,0 +1,68 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ethereum/go-ethereum/core/asm"
+	"gopkg.in/urfave/cli.v1"
+)
+
+// compileCommand는 .easm 소스 파일을 읽어 EVM 바이트코드로 어셈블합니다.
+// compileCommand reads an .easm source file and assembles it into EVM
+// bytecode.
+var compileCommand = cli.Command{
+	Action:    compileCmd,
+	Name:      "compile",
+	Usage:     "compiles easm source to evm binary",
+	ArgsUsage: "<file>",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "debug",
+			Usage: "output compilation trace",
+		},
+	},
+}
+
+func compileCmd(ctx *cli.Context) error {
+	debug := ctx.Bool("debug")
+
+	if len(ctx.Args().First()) == 0 {
+		return fmt.Errorf("filename required")
+	}
+
+	src, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	compiler := asm.NewCompiler(debug)
+	compiler.Feed(asm.Lex(string(src), debug))
+
+	bin, errs := compiler.Compile()
+	if len(errs) != 0 {
+		for _, err := range errs {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		os.Exit(1)
+	}
+	fmt.Println(bin)
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2014 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+// evm is a command-line tool for EVM code; its compile subcommand assembles
+// easm source into EVM bytecode.
+// evm은 EVM 코드를 위한 커맨드라인 도구입니다; compile 하위 명령은 easm
+// 소스를 EVM 바이트코드로 어셈블합니다.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/urfave/cli.v1"
+)
+
+// app는 evm 커맨드라인 애플리케이션입니다.
+// app is the evm command-line application.
+var app = cli.NewApp()
+
+func init() {
+	app.Name = "evm"
+	app.Usage = "the EVM command line interface"
+	app.Commands = []cli.Command{
+		compileCommand,
+	}
+}
+
+func main() {
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
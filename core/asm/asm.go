@@ -109,13 +109,73 @@ func (it *instructionIterator) Arg() []byte {
 	return it.arg
 }
 
+// Option configures the optional behavior of Disassemble and
+// PrintDisassembled around the CBOR metadata trailer that solc appends to
+// runtime bytecode. Without an option, that trailer is disassembled like
+// any other code, which produces garbage INVALID/PUSH output past the real
+// code end.
+// Option은 solc가 런타임 바이트코드 뒤에 덧붙이는 CBOR 메타데이터 트레일러에
+// 대한 Disassemble 및 PrintDisassembled의 선택적 동작을 설정합니다. 옵션이
+// 없으면 그 트레일러도 다른 코드와 마찬가지로 분해되어, 실제 코드 끝을
+// 지난 지점에서 garbage한 INVALID/PUSH 출력이 생성됩니다.
+type Option func(*disassembleConfig)
+
+type disassembleConfig struct {
+	stripMetadata    bool
+	annotateMetadata bool
+}
+
+// SkipMetadata makes Disassemble/PrintDisassembled detect and drop solc's
+// CBOR metadata trailer before disassembling, rather than decoding it as
+// bogus instructions.
+// SkipMetadata는 Disassemble/PrintDisassembled가 solc의 CBOR 메타데이터
+// 트레일러를, 잘못된 명령어로 디코딩하는 대신 분해 전에 감지하여 제거하도록
+// 만듭니다.
+func SkipMetadata() Option {
+	return func(c *disassembleConfig) { c.stripMetadata = true }
+}
+
+// AnnotateMetadata makes Disassemble/PrintDisassembled drop solc's CBOR
+// metadata trailer like SkipMetadata, and additionally append a summary line
+// describing the decoded metadata.
+// AnnotateMetadata는 Disassemble/PrintDisassembled가 SkipMetadata처럼 solc의
+// CBOR 메타데이터 트레일러를 제거하게 하며, 추가로 디코딩된 메타데이터를
+// 설명하는 요약 줄을 덧붙입니다.
+func AnnotateMetadata() Option {
+	return func(c *disassembleConfig) { c.stripMetadata = true; c.annotateMetadata = true }
+}
+
+// splitForOptions applies opts to script, returning the code that should
+// actually be disassembled and, if requested, a trailing summary line about
+// the metadata that was stripped off.
+// splitForOptions는 opts를 script에 적용하여, 실제로 분해되어야 할 코드와
+// (요청된 경우) 제거된 메타데이터에 대한 마지막 요약 줄을 반환합니다.
+func splitForOptions(script []byte, opts []Option) ([]byte, string) {
+	var cfg disassembleConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if !cfg.stripMetadata {
+		return script, ""
+	}
+	runtime, meta, err := SplitMetadata(script)
+	if err != nil {
+		return script, ""
+	}
+	if cfg.annotateMetadata {
+		return runtime, fmt.Sprintf("-- metadata: %s\n", meta)
+	}
+	return runtime, ""
+}
+
 // Pretty-print all disassembled EVM instructions to stdout.
 // 분해된 모든 EVM 인스트럭션을 stdout에 예쁘게 인쇄합니다.
-func PrintDisassembled(code string) error {
+func PrintDisassembled(code string, opts ...Option) error {
 	script, err := hex.DecodeString(code)
 	if err != nil {
 		return err
 	}
+	script, trailer := splitForOptions(script, opts)
 
 	it := NewInstructionIterator(script)
 	for it.Next() {
@@ -125,13 +185,17 @@ func PrintDisassembled(code string) error {
 			fmt.Printf("%06v: %v\n", it.PC(), it.Op())
 		}
 	}
+	if trailer != "" {
+		fmt.Print(trailer)
+	}
 	return it.Error()
 }
 
 // Return all disassembled EVM instructions in human-readable format.
 // 분해된 모든 EVM 명령어를 사람이 읽을 수 있는 형식으로 반환합니다.
-func Disassemble(script []byte) ([]string, error) {
+func Disassemble(script []byte, opts ...Option) ([]string, error) {
 	instrs := make([]string, 0)
+	script, trailer := splitForOptions(script, opts)
 
 	it := NewInstructionIterator(script)
 	for it.Next() {
@@ -144,5 +208,8 @@ func Disassemble(script []byte) ([]string, error) {
 	if err := it.Error(); err != nil {
 		return nil, err
 	}
+	if trailer != "" {
+		instrs = append(instrs, trailer)
+	}
 	return instrs, nil
 }
@@ -0,0 +1,357 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package cfg builds a basic-block control-flow graph on top of
+// core/asm's instruction iterator. It is a foundational building block for
+// gas analysis, dead-code detection and decompilation passes.
+// cfg 패키지는 core/asm의 명령어 반복자 위에 기본 블록 제어 흐름 그래프를
+// 구축합니다. 이는 가스 분석, 데드 코드 탐지, 디컴파일 패스 등을 위한
+// 기초적인 구성 요소입니다.
+package cfg
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// instr is a single decoded instruction, kept around after the
+// instructionIterator has moved on.
+// instr은 instructionIterator가 다음으로 넘어간 뒤에도 유지되는, 디코딩된
+// 단일 명령어입니다.
+type instr struct {
+	pc  uint64
+	op  vm.OpCode
+	arg []byte
+}
+
+// BasicBlock is a maximal straight-line run of instructions: control only
+// enters at Start and only leaves at the last instruction before End.
+// BasicBlock은 명령어들의 극대 직선 실행 구간입니다: 제어 흐름은 오직
+// Start에서만 들어오고, End 직전의 마지막 명령어에서만 나갑니다.
+type BasicBlock struct {
+	Start, End uint64 // [Start, End) in code offsets
+	Instrs     []asm.Instruction
+
+	Succs []uint64 // PCs of successor blocks, keyed by their Start
+	Preds []uint64 // PCs of predecessor blocks, keyed by their Start
+}
+
+// CFG is a control-flow graph over a contract's runtime bytecode, with
+// blocks keyed by their start PC.
+// CFG는 컨트랙트 런타임 바이트코드에 대한 제어 흐름 그래프이며, 블록들은
+// 시작 PC를 키로 하여 저장됩니다.
+type CFG struct {
+	Blocks map[uint64]*BasicBlock
+	Entry  uint64
+}
+
+// BuildCFG decodes code and constructs its control-flow graph.
+// BuildCFG는 code를 디코딩하여 그 제어 흐름 그래프를 구성합니다.
+func BuildCFG(code []byte) (*CFG, error) {
+	instrs, jumpdests, err := decode(code)
+	if err != nil {
+		return nil, err
+	}
+	leaders := findLeaders(instrs, jumpdests)
+
+	cfg := &CFG{Blocks: make(map[uint64]*BasicBlock), Entry: 0}
+	for i, start := range leaders {
+		end := uint64(len(code))
+		if i+1 < len(leaders) {
+			end = leaders[i+1]
+		}
+		cfg.Blocks[start] = &BasicBlock{Start: start, End: end}
+	}
+
+	// Assign instructions to their containing block.
+	blockOf := func(pc uint64) *BasicBlock {
+		// leaders is sorted, find the last leader <= pc.
+		idx := sort.Search(len(leaders), func(i int) bool { return leaders[i] > pc }) - 1
+		if idx < 0 {
+			return nil
+		}
+		return cfg.Blocks[leaders[idx]]
+	}
+	for _, in := range instrs {
+		b := blockOf(in.pc)
+		if b == nil {
+			continue
+		}
+		instr := asm.Instruction{Pc: in.pc, Op: in.op.String(), OpCode: uint8(in.op)}
+		if len(in.arg) > 0 {
+			instr.Arg = hex.EncodeToString(in.arg)
+			instr.ArgHex = "0x" + instr.Arg
+		}
+		b.Instrs = append(b.Instrs, instr)
+	}
+
+	// Resolve successors for each block from its final instruction.
+	for _, start := range leaders {
+		b := cfg.Blocks[start]
+		if len(b.Instrs) == 0 {
+			continue
+		}
+		last := b.Instrs[len(b.Instrs)-1]
+		op := vm.OpCode(last.OpCode)
+		fallthroughPC := b.End
+
+		switch op {
+		case vm.JUMP, vm.JUMPI:
+			if target, ok := staticJumpTarget(b.Instrs); ok {
+				if _, exists := cfg.Blocks[target]; exists {
+					addEdge(cfg, b.Start, target)
+				}
+			}
+			if op == vm.JUMPI {
+				if _, exists := cfg.Blocks[fallthroughPC]; exists {
+					addEdge(cfg, b.Start, fallthroughPC)
+				}
+			}
+		case vm.STOP, vm.RETURN, vm.REVERT, vm.INVALID, vm.SELFDESTRUCT:
+			// No successors: execution halts here.
+		default:
+			if _, exists := cfg.Blocks[fallthroughPC]; exists {
+				addEdge(cfg, b.Start, fallthroughPC)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// decode runs the instruction iterator over code once, collecting every
+// instruction and the set of valid JUMPDEST offsets.
+// decode는 code에 대해 명령어 반복자를 한 번 실행하여, 모든 명령어와 유효한
+// JUMPDEST 오프셋 집합을 수집합니다.
+func decode(code []byte) ([]instr, map[uint64]bool, error) {
+	var instrs []instr
+	jumpdests := make(map[uint64]bool)
+
+	it := asm.NewInstructionIterator(code)
+	for it.Next() {
+		arg := append([]byte(nil), it.Arg()...)
+		instrs = append(instrs, instr{pc: it.PC(), op: it.Op(), arg: arg})
+		if it.Op() == vm.JUMPDEST {
+			jumpdests[it.PC()] = true
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, nil, err
+	}
+	return instrs, jumpdests, nil
+}
+
+// findLeaders identifies the set of instruction offsets that start a new
+// basic block: the program entry point, every JUMPDEST, and the instruction
+// immediately following a JUMP, JUMPI, STOP, RETURN, REVERT, INVALID or
+// SELFDESTRUCT.
+// findLeaders는 새 기본 블록을 시작하는 명령어 오프셋 집합을 식별합니다:
+// 프로그램 진입점, 모든 JUMPDEST, 그리고 JUMP, JUMPI, STOP, RETURN, REVERT,
+// INVALID, SELFDESTRUCT 바로 다음에 오는 명령어입니다.
+func findLeaders(instrs []instr, jumpdests map[uint64]bool) []uint64 {
+	set := map[uint64]bool{0: true}
+	for pc := range jumpdests {
+		set[pc] = true
+	}
+	for i, in := range instrs {
+		switch in.op {
+		case vm.JUMP, vm.JUMPI, vm.STOP, vm.RETURN, vm.REVERT, vm.INVALID, vm.SELFDESTRUCT:
+			if i+1 < len(instrs) {
+				set[instrs[i+1].pc] = true
+			}
+		}
+	}
+	leaders := make([]uint64, 0, len(set))
+	for pc := range set {
+		leaders = append(leaders, pc)
+	}
+	sort.Slice(leaders, func(i, j int) bool { return leaders[i] < leaders[j] })
+	return leaders
+}
+
+// staticJumpTarget tries to determine the destination of a JUMP/JUMPI that
+// terminates a basic block by symbolically evaluating the block's stack
+// effect: PUSHes are tracked as known constants, DUP/SWAP move constants
+// around, and any other opcode makes the value it produces unknown. It
+// gives up as soon as the value under the jump turns out not to be a
+// constant.
+// staticJumpTarget은 기본 블록을 끝맺는 JUMP/JUMPI의 목적지를, 블록의 스택
+// 효과를 기호적으로 평가하여 알아내려고 시도합니다: PUSH는 알려진 상수로
+// 추적되고, DUP/SWAP은 상수들의 위치를 옮기며, 그 외의 opcode는 그것이
+// 만들어내는 값을 알 수 없는 것으로 만듭니다. jump 아래에 있는 값이 상수가
+// 아닌 것으로 판명되면 즉시 포기합니다.
+func staticJumpTarget(instrs []asm.Instruction) (uint64, bool) {
+	var stack []*big.Int // top of stack is the last element
+
+	push := func(v *big.Int) {
+		stack = append(stack, v)
+	}
+	pop := func() *big.Int {
+		if len(stack) == 0 {
+			return nil
+		}
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v
+	}
+
+	// The jump/jumpi instruction itself consumes the target (and, for
+	// jumpi, the condition), so only look at the instructions before it.
+	for _, in := range instrs[:len(instrs)-1] {
+		op := vm.OpCode(in.OpCode)
+		switch {
+		case op.IsPush():
+			v, ok := new(big.Int).SetString(in.Arg, 16)
+			if !ok {
+				push(nil)
+			} else {
+				push(v)
+			}
+		case op >= vm.DUP1 && op <= vm.DUP16:
+			n := int(op-vm.DUP1) + 1
+			if len(stack) < n {
+				push(nil)
+				continue
+			}
+			push(stack[len(stack)-n])
+		case op >= vm.SWAP1 && op <= vm.SWAP16:
+			n := int(op-vm.SWAP1) + 1
+			// Slots below the symbolic stack hold values from before the
+			// block, which are unknown.
+			for len(stack) < n+1 {
+				stack = append([]*big.Int{nil}, stack...)
+			}
+			stack[len(stack)-1], stack[len(stack)-n-1] = stack[len(stack)-n-1], stack[len(stack)-1]
+		default:
+			pops, pushes, known := stackEffect(op)
+			if !known {
+				// op isn't on the known-safe allowlist, so we don't actually
+				// know how many words it consumes. Guessing an arity (e.g.
+				// defaulting to 0 pops) would desynchronize the symbolic
+				// stack from the real one, letting a stale value underneath
+				// surface as if it were today's top via a later DUP/SWAP —
+				// a wrong static jump target is worse than a missed one.
+				stack = nil
+				continue
+			}
+			for i := 0; i < pops; i++ {
+				pop()
+			}
+			for i := 0; i < pushes; i++ {
+				push(nil)
+			}
+		}
+	}
+
+	top := pop()
+	if top == nil {
+		return 0, false
+	}
+	return top.Uint64(), true
+}
+
+// stackEffect reports the exact number of words op pops and pushes, and
+// whether op is on the known-safe allowlist at all. PUSHn, DUPn and SWAPn are
+// handled separately by staticJumpTarget and never reach here. Every other
+// real opcode — CALLDATALOAD, CALL and friends, LOGn, CREATE/CREATE2, and so
+// on — is deliberately left off this list: see the default case in
+// staticJumpTarget for why an unrecognized opcode must invalidate the whole
+// stack rather than be assigned a guessed arity.
+// stackEffect는 op이 팝/푸시하는 정확한 워드 개수와, op이 애초에 known-safe
+// 허용 목록에 있는지 여부를 보고합니다. PUSHn, DUPn, SWAPn은 staticJumpTarget이
+// 별도로 처리하며 여기까지 오지 않습니다. CALLDATALOAD, CALL 계열, LOGn,
+// CREATE/CREATE2 등 그 밖의 실제 opcode들은 의도적으로 이 목록에서 제외되어
+// 있습니다: 인식되지 않은 opcode에 추정한 입출력 개수를 부여하는 대신 전체
+// 스택을 무효화해야 하는 이유는 staticJumpTarget의 default 케이스를 참고하세요.
+func stackEffect(op vm.OpCode) (pops, pushes int, known bool) {
+	switch {
+	case op == vm.JUMPDEST:
+		return 0, 0, true
+	case op == vm.PC, op == vm.MSIZE, op == vm.GAS, op == vm.CALLER, op == vm.CALLVALUE,
+		op == vm.ADDRESS, op == vm.ORIGIN, op == vm.CODESIZE, op == vm.GASPRICE,
+		op == vm.COINBASE, op == vm.TIMESTAMP, op == vm.NUMBER, op == vm.DIFFICULTY,
+		op == vm.GASLIMIT, op == vm.RETURNDATASIZE, op == vm.CHAINID, op == vm.SELFBALANCE,
+		op == vm.BASEFEE:
+		return 0, 1, true
+	case op == vm.ADD, op == vm.SUB, op == vm.MUL, op == vm.DIV, op == vm.SDIV,
+		op == vm.MOD, op == vm.SMOD, op == vm.EXP, op == vm.SIGNEXTEND,
+		op == vm.LT, op == vm.GT, op == vm.SLT, op == vm.SGT, op == vm.EQ,
+		op == vm.AND, op == vm.OR, op == vm.XOR, op == vm.BYTE, op == vm.SHL,
+		op == vm.SHR, op == vm.SAR, op == vm.SHA3:
+		return 2, 1, true
+	case op == vm.MLOAD, op == vm.SLOAD, op == vm.ISZERO, op == vm.NOT:
+		return 1, 1, true
+	case op == vm.POP:
+		return 1, 0, true
+	case op == vm.MSTORE, op == vm.MSTORE8, op == vm.SSTORE:
+		return 2, 0, true
+	case op == vm.ADDMOD, op == vm.MULMOD:
+		return 3, 1, true
+	default:
+		return 0, 0, false
+	}
+}
+
+func addEdge(cfg *CFG, from, to uint64) {
+	cfg.Blocks[from].Succs = append(cfg.Blocks[from].Succs, to)
+	cfg.Blocks[to].Preds = append(cfg.Blocks[to].Preds, from)
+}
+
+// Dot renders the control-flow graph in Graphviz's DOT format.
+// Dot은 제어 흐름 그래프를 Graphviz의 DOT 형식으로 렌더링합니다.
+func (c *CFG) Dot() string {
+	starts := make([]uint64, 0, len(c.Blocks))
+	for pc := range c.Blocks {
+		starts = append(starts, pc)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	var b strings.Builder
+	b.WriteString("digraph cfg {\n")
+	b.WriteString("\tnode [shape=box, fontname=monospace];\n")
+	for _, pc := range starts {
+		block := c.Blocks[pc]
+		b.WriteString(fmt.Sprintf("\t%q [label=%q];\n", label(pc), blockLabel(block)))
+	}
+	for _, pc := range starts {
+		for _, succ := range c.Blocks[pc].Succs {
+			b.WriteString(fmt.Sprintf("\t%q -> %q;\n", label(pc), label(succ)))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func label(pc uint64) string {
+	return fmt.Sprintf("block_%d", pc)
+}
+
+func blockLabel(b *BasicBlock) string {
+	var lines []string
+	for _, in := range b.Instrs {
+		if in.Arg != "" {
+			lines = append(lines, fmt.Sprintf("%d: %s 0x%s", in.Pc, in.Op, in.Arg))
+		} else {
+			lines = append(lines, fmt.Sprintf("%d: %s", in.Pc, in.Op))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,96 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package cfg
+
+import "testing"
+
+// TestBuildCFGStaticJump checks that a trivial PUSH+JUMP resolves to the
+// JUMPDEST it targets, which requires the symbolic stack in
+// staticJumpTarget to actually see the pushed constant.
+// TestBuildCFGStaticJump은 단순한 PUSH+JUMP가 그 목표 JUMPDEST로 해석되는지
+// 확인하며, 이는 staticJumpTarget의 기호적 스택이 푸시된 상수를 실제로
+// 인식해야만 성립합니다.
+func TestBuildCFGStaticJump(t *testing.T) {
+	// PUSH1 0x03; JUMP; JUMPDEST; STOP
+	code := []byte{0x60, 0x03, 0x56, 0x5b, 0x00}
+
+	g, err := BuildCFG(code)
+	if err != nil {
+		t.Fatalf("BuildCFG failed: %v", err)
+	}
+
+	entry, ok := g.Blocks[0]
+	if !ok {
+		t.Fatalf("missing entry block")
+	}
+	if len(entry.Instrs) != 2 || entry.Instrs[0].Arg != "03" {
+		t.Fatalf("unexpected entry block instructions: %+v", entry.Instrs)
+	}
+	if len(entry.Succs) != 1 || entry.Succs[0] != 3 {
+		t.Fatalf("entry block successors = %v, want [3]", entry.Succs)
+	}
+
+	target, ok := g.Blocks[3]
+	if !ok {
+		t.Fatalf("missing block for JUMPDEST at pc 3")
+	}
+	if len(target.Succs) != 0 {
+		t.Fatalf("target block successors = %v, want none (ends in STOP)", target.Succs)
+	}
+	if len(target.Preds) != 1 || target.Preds[0] != 0 {
+		t.Fatalf("target block predecessors = %v, want [0]", target.Preds)
+	}
+}
+
+// TestBuildCFGUnknownOpcodeInvalidatesStack checks that an opcode not on
+// staticJumpTarget's known-safe allowlist (CALLDATALOAD here) clears the
+// symbolic stack instead of being assigned a guessed arity. Before the
+// pop/push tables were completed, CALLDATALOAD was treated as 0-pop/1-push,
+// which left the constant pushed just before it sitting one slot further
+// down the stack than it should be; a DUP then resurfaced that stale
+// constant as if it were the real (dynamic) jump target, planting a
+// plausible-looking but wrong edge to the JUMPDEST that stale value happens
+// to equal.
+// TestBuildCFGUnknownOpcodeInvalidatesStack은 staticJumpTarget의 known-safe
+// 허용 목록에 없는 opcode(CALLDATALOAD)가, 추정한 입출력 개수를 부여받는
+// 대신 기호적 스택을 무효화하는지 확인합니다. pop/push 표가 완성되기 전에는
+// CALLDATALOAD가 0팝/1푸시로 취급되어, 바로 직전에 푸시된 상수가 실제보다
+// 한 칸 더 아래에 남아 있었습니다; 그 뒤의 DUP가 그 오래된 상수를 마치
+// 실제(동적인) 점프 목적지인 것처럼 다시 끌어올려, 그 값과 우연히 같은
+// JUMPDEST로 향하는 그럴듯하지만 잘못된 엣지를 만들어냈습니다.
+func TestBuildCFGUnknownOpcodeInvalidatesStack(t *testing.T) {
+	// PUSH1 0x08; CALLDATALOAD; DUP2; JUMP; JUMPDEST*4; STOP
+	//
+	// 0x08 is a decoy: it's left on the stack under CALLDATALOAD's result,
+	// and a JUMPDEST happens to sit at pc 8 so that, if CALLDATALOAD were
+	// mis-treated as a 0-pop op, DUP2 would resurface the decoy and
+	// staticJumpTarget would resolve a "valid" but wrong edge to it.
+	code := []byte{0x60, 0x08, 0x35, 0x81, 0x56, 0x5b, 0x5b, 0x5b, 0x5b, 0x00}
+
+	g, err := BuildCFG(code)
+	if err != nil {
+		t.Fatalf("BuildCFG failed: %v", err)
+	}
+
+	entry, ok := g.Blocks[0]
+	if !ok {
+		t.Fatalf("missing entry block")
+	}
+	if len(entry.Succs) != 0 {
+		t.Fatalf("entry block successors = %v, want none: CALLDATALOAD's result isn't statically known", entry.Succs)
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// Compiler is the EVM assembly compiler. It consumes a token stream produced
+// by Lex and turns it into EVM bytecode, resolving label references to
+// PUSHn <offset> instructions along the way.
+// Compiler는 EVM 어셈블리 컴파일러입니다. Lex가 생성한 토큰 스트림을 소비하여
+// EVM 바이트코드로 변환하며, 그 과정에서 레이블 참조를 PUSHn <offset> 명령어로
+// 해석합니다.
+type Compiler struct {
+	tokens []token
+	binary []byte
+
+	labels map[string]int
+
+	pos, size int
+	debug     bool
+}
+
+// NewCompiler creates a new Compiler. When debug is set the compiler prints
+// verbose diagnostics for every compiled line to stdout.
+// NewCompiler는 새 Compiler를 생성합니다. debug가 설정되면 컴파일되는 각 줄에
+// 대한 상세한 진단 정보를 표준 출력에 출력합니다.
+func NewCompiler(debug bool) *Compiler {
+	return &Compiler{
+		labels: make(map[string]int),
+		debug:  debug,
+	}
+}
+
+// Feed feeds the compiler with tokens from a lexer token stream until the
+// channel is closed.
+// Feed는 채널이 닫힐 때까지 렉서 토큰 스트림에서 나온 토큰들을 컴파일러에
+// 공급합니다.
+func (c *Compiler) Feed(ch <-chan token) {
+	for i := range ch {
+		if i.typ != lineEnd {
+			c.tokens = append(c.tokens, i)
+		}
+	}
+}
+
+// Compile compiles the fed token stream and returns the resulting bytecode
+// as a hex string, along with any errors that occurred. Compilation proceeds
+// in two passes: the first records every label's final offset (expanding
+// each "@label" reference to the smallest PUSHn that can hold it, iterating
+// until the offsets stop changing), and the second emits the final bytes.
+// Compile은 공급된 토큰 스트림을 컴파일하여 그 결과 바이트코드를 16진수
+// 문자열로 반환하며, 발생한 오류들도 함께 반환합니다. 컴파일은 두 번의 패스로
+// 진행됩니다: 첫 번째 패스는 모든 레이블의 최종 오프셋을 기록하고("@label"
+// 참조를 해당 오프셋을 담을 수 있는 가장 작은 PUSHn으로 확장하며, 오프셋이
+// 더 이상 바뀌지 않을 때까지 반복합니다), 두 번째 패스는 최종 바이트를
+// 내보냅니다.
+func (c *Compiler) Compile() (string, []error) {
+	var errors []error
+
+	// First pass: resolve label offsets. PUSHn sizing depends on the final
+	// offset of a label, and the offset of a label depends on the PUSHn
+	// sizes chosen for every preceding label reference, so we iterate until
+	// a fixed point is reached.
+	for {
+		changed, err := c.compileLines()
+		if len(err) != 0 {
+			errors = append(errors, err...)
+			return "", errors
+		}
+		if !changed {
+			break
+		}
+	}
+
+	c.pos = 0
+	c.size = 0
+	var bin []byte
+	for i := 0; i < len(c.tokens); i++ {
+		if err := c.compileLine(i, &bin); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	if len(errors) > 0 {
+		return "", errors
+	}
+	return hex.EncodeToString(bin), nil
+}
+
+// compileLines runs a pass over the token stream purely to (re-)compute
+// label offsets. It returns whether any label's offset changed relative to
+// the previous pass, which drives the fixed-point iteration in Compile.
+// compileLines는 레이블 오프셋을 (재)계산하기 위해서만 토큰 스트림에 대해
+// 한 번의 패스를 수행합니다. 이전 패스와 비교해 레이블 오프셋이 변경되었는지
+// 여부를 반환하며, 이는 Compile의 고정점 반복을 이끕니다.
+func (c *Compiler) compileLines() (bool, []error) {
+	var (
+		errors  []error
+		pos     int
+		changed bool
+	)
+	for i := 0; i < len(c.tokens); i++ {
+		tok := c.tokens[i]
+		switch tok.typ {
+		case labelDefinition:
+			if prev, ok := c.labels[tok.text]; !ok || prev != pos {
+				c.labels[tok.text] = pos
+				changed = true
+			}
+		case label:
+			pos += 1 + pushSize(c.labels[tok.text])
+		case element:
+			if op, ok := vm.StringToOp(tok.text); ok && !isUnknown(op) {
+				pos++
+			} else if prev, ok := c.labels[tok.text]; ok {
+				pos += 1 + pushSize(prev)
+			} else {
+				errors = append(errors, fmt.Errorf("line %d:%d: unknown instruction %q", tok.lineno, tok.column, tok.text))
+			}
+		case number:
+			pos += numberSize(tok.text)
+		case stringValue:
+			pos += len(tok.text)
+		}
+	}
+	return changed, errors
+}
+
+// compileLine appends the bytecode for the i'th token to bin, using the
+// label offsets computed by compileLines.
+// compileLine은 compileLines가 계산한 레이블 오프셋을 사용하여, i번째
+// 토큰에 해당하는 바이트코드를 bin에 덧붙입니다.
+func (c *Compiler) compileLine(i int, bin *[]byte) error {
+	tok := c.tokens[i]
+	switch tok.typ {
+	case labelDefinition:
+		// Label definitions don't emit bytes themselves.
+		return nil
+	case label:
+		offset := c.labels[tok.text]
+		n := pushSize(offset)
+		*bin = append(*bin, byte(vm.PUSH1)+byte(n-1))
+		*bin = append(*bin, padBigEndian(offset, n)...)
+		if c.debug {
+			fmt.Printf("%d: PUSH%d @%s (0x%x)\n", tok.lineno, n, tok.text, offset)
+		}
+	case element:
+		if op, ok := vm.StringToOp(tok.text); ok && !isUnknown(op) {
+			*bin = append(*bin, byte(op))
+			if c.debug {
+				fmt.Printf("%d: %v\n", tok.lineno, op)
+			}
+			return nil
+		}
+		if offset, ok := c.labels[tok.text]; ok {
+			n := pushSize(offset)
+			*bin = append(*bin, byte(vm.PUSH1)+byte(n-1))
+			*bin = append(*bin, padBigEndian(offset, n)...)
+			return nil
+		}
+		return fmt.Errorf("line %d:%d: unknown instruction %q", tok.lineno, tok.column, tok.text)
+	case number:
+		n, ok := new(big.Int).SetString(tok.text, 0)
+		if !ok {
+			return fmt.Errorf("line %d:%d: invalid number %q", tok.lineno, tok.column, tok.text)
+		}
+		out := make([]byte, numberSize(tok.text))
+		n.FillBytes(out)
+		*bin = append(*bin, out...)
+	case stringValue:
+		*bin = append(*bin, []byte(tok.text)...)
+	case invalidStatement:
+		return fmt.Errorf("line %d:%d: %s", tok.lineno, tok.column, tok.text)
+	}
+	return nil
+}
+
+// pushSize returns the number of bytes the smallest PUSHn instruction that
+// can represent offset needs, with a minimum of 1.
+// pushSize는 offset을 표현할 수 있는 가장 작은 PUSHn 명령어에 필요한 바이트
+// 수를 반환하며, 최솟값은 1입니다.
+func pushSize(offset int) int {
+	n := 1
+	for v := offset; v > 0xff; v >>= 8 {
+		n++
+	}
+	return n
+}
+
+// numberSize returns the number of bytes needed to encode the given decimal
+// or hex literal.
+// numberSize는 주어진 십진수 또는 16진수 리터럴을 인코딩하는 데 필요한
+// 바이트 수를 반환합니다.
+func numberSize(text string) int {
+	n, ok := new(big.Int).SetString(text, 0)
+	if !ok {
+		return 1
+	}
+	if len(n.Bytes()) == 0 {
+		return 1
+	}
+	return len(n.Bytes())
+}
+
+// padBigEndian returns offset encoded as a big-endian byte slice of exactly
+// n bytes.
+// padBigEndian은 offset을 정확히 n바이트 길이의 빅 엔디안 바이트 슬라이스로
+// 인코딩하여 반환합니다.
+func padBigEndian(offset, n int) []byte {
+	out := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		out[i] = byte(offset)
+		offset >>= 8
+	}
+	return out
+}
+
+// isUnknown reports whether op is the catch-all "unknown opcode" value that
+// vm.StringToOp returns for input it doesn't recognize.
+// isUnknown은 op이 vm.StringToOp가 인식하지 못하는 입력에 대해 반환하는
+// 포괄적인 "알 수 없는 opcode" 값인지 여부를 보고합니다.
+func isUnknown(op vm.OpCode) bool {
+	return op.String() == "opcode " || op.String() == ""
+}
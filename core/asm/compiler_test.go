@@ -0,0 +1,98 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func compile(t *testing.T, src string) string {
+	t.Helper()
+
+	c := NewCompiler(false)
+	c.Feed(Lex(src, false))
+	bin, errs := c.Compile()
+	if len(errs) != 0 {
+		t.Fatalf("compile(%q) failed: %v", src, errs)
+	}
+	return bin
+}
+
+func TestCompilerNumberLiterals(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"PUSH1 0x01\nPUSH1 0x02\nADD\nSTOP", "600160020100"},
+		{"PUSH1 0x00\nSTOP", "600000"},
+	}
+	for _, tt := range tests {
+		if got := compile(t, tt.src); got != tt.want {
+			t.Errorf("compile(%q) = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+// TestCompilerLabelRoundtrip checks that a label reference compiles to a
+// PUSHn whose operand is the label's actual offset, and that the resulting
+// bytecode disassembles back into the instructions it was built from.
+// TestCompilerLabelRoundtrip은 레이블 참조가 그 레이블의 실제 오프셋을
+// 피연산자로 하는 PUSHn으로 컴파일되는지, 그리고 그 결과 바이트코드가 원래
+// 만들어졌던 명령어들로 다시 분해되는지를 확인합니다.
+func TestCompilerLabelRoundtrip(t *testing.T) {
+	src := "PUSH1 0x00\nJUMPI @dest\nJUMPDEST\ndest:\nSTOP"
+
+	bin := compile(t, src)
+
+	script, err := hex.DecodeString(bin)
+	if err != nil {
+		t.Fatalf("invalid hex produced: %v", err)
+	}
+	instrs, err := Disassemble(script)
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+	// PUSH1 0x00, JUMPI, PUSH1 <dest>, JUMPDEST, STOP.
+	if len(instrs) != 5 {
+		t.Fatalf("got %d instructions, want 5: %v", len(instrs), instrs)
+	}
+	// dest: is at pc 6, after the 2-byte PUSH1 that @dest expands to.
+	if want := "000003: PUSH1 0x06\n"; instrs[2] != want {
+		t.Errorf("label reference = %q, want %q", instrs[2], want)
+	}
+}
+
+// TestCompilerErrorLineColumn checks that a compile error on a line other
+// than the first reports both the line and the column of the offending
+// token, not just its line.
+// TestCompilerErrorLineColumn은 첫 번째 줄이 아닌 다른 줄에서 발생한 컴파일
+// 오류가 해당 토큰의 줄뿐 아니라 열까지 보고하는지 확인합니다.
+func TestCompilerErrorLineColumn(t *testing.T) {
+	src := "PUSH1 0x00\n  BOGUS"
+
+	c := NewCompiler(false)
+	c.Feed(Lex(src, false))
+	_, errs := c.Compile()
+	if len(errs) != 1 {
+		t.Fatalf("Compile() returned %d errors, want 1: %v", len(errs), errs)
+	}
+	if want := "line 2:3: unknown instruction"; !strings.Contains(errs[0].Error(), want) {
+		t.Errorf("error = %q, want it to contain %q", errs[0].Error(), want)
+	}
+}
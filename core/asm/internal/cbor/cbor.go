@@ -0,0 +1,214 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package cbor implements just enough of RFC 7049 to decode the small CBOR
+// maps that solc appends to contract runtime bytecode. It is not a
+// general-purpose CBOR library: indefinite-length items and floating point
+// values are rejected with an error, and tags are skipped (only the item
+// they wrap is returned), since the metadata trailer never contains them.
+// cbor 패키지는 solc가 컨트랙트 런타임 바이트코드 뒤에 덧붙이는 작은 CBOR
+// 맵을 디코딩하는 데 필요한 만큼만 RFC 7049를 구현합니다. 범용 CBOR
+// 라이브러리가 아닙니다: 메타데이터 트레일러에는 결코 등장하지 않으므로,
+// 비정형 길이 항목과 부동소수점 값은 오류로 거부되고, 태그는 건너뜁니다
+// (태그가 감싼 항목만 반환됩니다).
+package cbor
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	majorUnsigned = 0
+	majorNegative = 1
+	majorBytes    = 2
+	majorText     = 3
+	majorArray    = 4
+	majorMap      = 5
+	majorTag      = 6
+	majorSimple   = 7
+)
+
+// decoder reads successive CBOR items from a byte slice.
+// decoder는 바이트 슬라이스로부터 연속된 CBOR 항목을 읽습니다.
+type decoder struct {
+	data []byte
+	pos  int
+}
+
+// Decode parses a single, complete CBOR item from data and returns it as one
+// of: uint64, int64 (for negative integers), []byte, string, bool, nil,
+// []interface{} or map[string]interface{}.
+// Decode는 data로부터 완전한 단일 CBOR 항목을 파싱하여, uint64, int64(음의
+// 정수), []byte, string, bool, nil, []interface{}, map[string]interface{} 중 하나로
+// 반환합니다.
+func Decode(data []byte) (interface{}, error) {
+	d := &decoder{data: data}
+	v, err := d.readItem()
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (d *decoder) readItem() (interface{}, error) {
+	b, err := d.byteAt(d.pos)
+	if err != nil {
+		return nil, err
+	}
+	major := b >> 5
+	minor := b & 0x1f
+	d.pos++
+
+	length, err := d.readLength(minor)
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case majorUnsigned:
+		return length, nil
+	case majorNegative:
+		return -1 - int64(length), nil
+	case majorBytes:
+		return d.readBytesOfLength(length)
+	case majorText:
+		raw, err := d.readBytesOfLength(length)
+		if err != nil {
+			return nil, err
+		}
+		return string(raw), nil
+	case majorArray:
+		items := make([]interface{}, 0, length)
+		for i := uint64(0); i < length; i++ {
+			v, err := d.readItem()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		return items, nil
+	case majorMap:
+		m := make(map[string]interface{}, length)
+		for i := uint64(0); i < length; i++ {
+			k, err := d.readItem()
+			if err != nil {
+				return nil, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("cbor: non-string map key %v", k)
+			}
+			v, err := d.readItem()
+			if err != nil {
+				return nil, err
+			}
+			m[key] = v
+		}
+		return m, nil
+	case majorTag:
+		// Tags just wrap the following item; the tag number itself is
+		// uninteresting for metadata decoding.
+		return d.readItem()
+	case majorSimple:
+		switch minor {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		case 25, 26, 27:
+			return nil, fmt.Errorf("cbor: floating point values are not supported")
+		default:
+			return length, nil
+		}
+	default:
+		return nil, fmt.Errorf("cbor: unsupported major type %d", major)
+	}
+}
+
+// readLength decodes the argument that follows a type byte: minor itself if
+// it's below 24, or a following 1/2/4/8-byte big-endian integer otherwise.
+// readLength는 타입 바이트 다음에 오는 인수를 디코딩합니다: minor가 24
+// 미만이면 minor 자신, 그렇지 않으면 뒤따르는 1/2/4/8바이트 빅 엔디안
+// 정수입니다.
+func (d *decoder) readLength(minor byte) (uint64, error) {
+	switch {
+	case minor < 24:
+		return uint64(minor), nil
+	case minor == 24:
+		b, err := d.readBytes(1)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(b[0]), nil
+	case minor == 25:
+		b, err := d.readBytes(2)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.BigEndian.Uint16(b)), nil
+	case minor == 26:
+		b, err := d.readBytes(4)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(binary.BigEndian.Uint32(b)), nil
+	case minor == 27:
+		b, err := d.readBytes(8)
+		if err != nil {
+			return 0, err
+		}
+		return binary.BigEndian.Uint64(b), nil
+	default:
+		return 0, fmt.Errorf("cbor: indefinite-length items are not supported")
+	}
+}
+
+// readBytesOfLength reads the length-prefixed byte/text payload described by
+// a major-2 or major-3 item. length comes straight off the wire, so it is
+// checked against the remaining input before being narrowed to an int:
+// on a 64-bit build, casting an oversized uint64 length directly to int can
+// wrap negative and smuggle an invalid slice expression past readBytes'
+// bounds check.
+// readBytesOfLength는 major-2 또는 major-3 항목이 나타내는, 길이가 앞에 붙은
+// 바이트/텍스트 페이로드를 읽습니다. length는 입력에서 그대로 읽은 값이므로,
+// int로 좁히기 전에 남은 입력 길이와 비교해 검사합니다: 64비트 빌드에서는,
+// 지나치게 큰 uint64 length를 곧장 int로 변환하면 음수로 넘어가 readBytes의
+// 경계 검사를 무력화하는 잘못된 슬라이스 표현식을 만들어낼 수 있습니다.
+func (d *decoder) readBytesOfLength(length uint64) ([]byte, error) {
+	if length > uint64(len(d.data)) {
+		return nil, fmt.Errorf("cbor: item length %d exceeds input size %d", length, len(d.data))
+	}
+	return d.readBytes(int(length))
+}
+
+func (d *decoder) readBytes(n int) ([]byte, error) {
+	if d.pos+n > len(d.data) {
+		return nil, fmt.Errorf("cbor: unexpected end of input")
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) byteAt(i int) (byte, error) {
+	if i >= len(d.data) {
+		return 0, fmt.Errorf("cbor: unexpected end of input")
+	}
+	return d.data[i], nil
+}
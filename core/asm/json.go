@@ -0,0 +1,97 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io"
+)
+
+// Instruction is a single disassembled EVM instruction in a form suitable
+// for JSON encoding, intended for external analyzers, coverage tools and IDE
+// plugins that would otherwise have to regex-parse the human-readable
+// disassembly produced by Disassemble.
+// Instruction은 JSON 인코딩에 적합한 형태의 단일 분해된 EVM 명령어이며,
+// Disassemble이 생성하는 사람이 읽을 수 있는 분해 결과를 정규식으로 파싱해야
+// 했던 외부 분석 도구, 커버리지 도구, IDE 플러그인 등을 위한 것입니다.
+type Instruction struct {
+	Pc     uint64 `json:"pc"`
+	Op     string `json:"op"`
+	OpCode uint8  `json:"opcode"`
+	Arg    string `json:"arg,omitempty"`
+	ArgHex string `json:"argHex,omitempty"`
+}
+
+// DisassembleJSON returns all disassembled EVM instructions of script as a
+// slice of Instruction, the JSON-friendly counterpart to Disassemble. It
+// accepts the same Options as Disassemble for handling solc's CBOR metadata
+// trailer.
+// DisassembleJSON은 script를 분해한 모든 EVM 명령어를, Disassemble의 JSON
+// 친화적인 대응물인 Instruction 슬라이스로 반환합니다. solc의 CBOR 메타데이터
+// 트레일러를 처리하기 위해 Disassemble과 동일한 Option들을 받습니다.
+func DisassembleJSON(script []byte, opts ...Option) ([]Instruction, error) {
+	var instrs []Instruction
+	script, _ = splitForOptions(script, opts)
+
+	it := NewInstructionIterator(script)
+	for it.Next() {
+		instrs = append(instrs, toInstruction(it))
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return instrs, nil
+}
+
+// EncodeJSON streams the disassembly of script to enc as it is produced,
+// one Instruction at a time, so that large contracts can be piped into
+// jq-style tooling without buffering the whole result in memory. It accepts
+// the same Options as Disassemble for handling solc's CBOR metadata trailer.
+// EncodeJSON은 script의 분해 결과를 생성되는 대로 한 번에 하나의 Instruction씩
+// enc로 스트리밍하므로, 대용량 컨트랙트도 전체 결과를 메모리에 버퍼링하지
+// 않고 jq 스타일 도구로 파이프할 수 있습니다. solc의 CBOR 메타데이터
+// 트레일러를 처리하기 위해 Disassemble과 동일한 Option들을 받습니다.
+func EncodeJSON(w io.Writer, script []byte, opts ...Option) error {
+	enc := json.NewEncoder(w)
+	script, _ = splitForOptions(script, opts)
+
+	it := NewInstructionIterator(script)
+	for it.Next() {
+		if err := enc.Encode(toInstruction(it)); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// toInstruction converts the instruction currently pointed to by it into its
+// JSON-friendly representation.
+// toInstruction은 it이 현재 가리키는 명령어를 JSON 친화적인 표현으로
+// 변환합니다.
+func toInstruction(it *instructionIterator) Instruction {
+	instr := Instruction{
+		Pc:     it.PC(),
+		Op:     it.Op().String(),
+		OpCode: byte(it.Op()),
+	}
+	if arg := it.Arg(); len(arg) > 0 {
+		instr.Arg = hex.EncodeToString(arg)
+		instr.ArgHex = "0x" + instr.Arg
+	}
+	return instr
+}
@@ -0,0 +1,74 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestDisassembleJSON(t *testing.T) {
+	// PUSH1 0x2a; STOP
+	script := []byte{0x60, 0x2a, 0x00}
+
+	instrs, err := DisassembleJSON(script)
+	if err != nil {
+		t.Fatalf("DisassembleJSON failed: %v", err)
+	}
+	if len(instrs) != 2 {
+		t.Fatalf("got %d instructions, want 2: %+v", len(instrs), instrs)
+	}
+	if instrs[0].Pc != 0 || instrs[0].Arg != "2a" || instrs[0].ArgHex != "0x2a" {
+		t.Errorf("unexpected first instruction: %+v", instrs[0])
+	}
+	if instrs[1].Pc != 2 || instrs[1].Arg != "" {
+		t.Errorf("unexpected second instruction: %+v", instrs[1])
+	}
+}
+
+func TestEncodeJSON(t *testing.T) {
+	script := []byte{0x60, 0x2a, 0x00}
+
+	var buf bytes.Buffer
+	if err := EncodeJSON(&buf, script); err != nil {
+		t.Fatalf("EncodeJSON failed: %v", err)
+	}
+
+	dec := json.NewDecoder(&buf)
+	var got []Instruction
+	for dec.More() {
+		var instr Instruction
+		if err := dec.Decode(&instr); err != nil {
+			t.Fatalf("decoding streamed instruction: %v", err)
+		}
+		got = append(got, instr)
+	}
+
+	want, err := DisassembleJSON(script)
+	if err != nil {
+		t.Fatalf("DisassembleJSON failed: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("streamed %d instructions, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("instruction %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
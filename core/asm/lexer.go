@@ -0,0 +1,298 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 이 렉서는 text/template 패키지 및 Rob Pike의 강연 "Lexical Scanning in Go"에서
+// 사용된 설계를 기반으로 합니다: http://cuddle.googlecode.com/hg/talk/lex.html
+// This lexer is based on the lexer from the "text/template" package and
+// Rob Pike's talk "Lexical Scanning in Go": http://cuddle.googlecode.com/hg/talk/lex.html
+const (
+	decimalNumbers = "1234567890"
+	hexNumbers     = decimalNumbers + "abcdefABCDEF"
+	alpha          = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// tokenType identifies the type of lexical token produced by the lexer.
+// tokenType은 렉서가 생성하는 어휘 토큰의 종류를 나타냅니다.
+type tokenType int
+
+const (
+	eof tokenType = iota
+	lineStart
+	lineEnd
+	invalidStatement
+	element
+	labelDefinition
+	label
+	number
+	stringValue
+)
+
+// token is a single lexical token together with the 1-indexed line and
+// column it was found on, used by the Compiler to reconstruct source
+// positions in error messages.
+// token은 하나의 어휘 토큰과 그 토큰이 발견된 1부터 시작하는 줄 및 열 번호를
+// 함께 담고 있으며, 컴파일러가 오류 메시지에서 소스 위치를 재구성하는 데
+// 사용합니다.
+type token struct {
+	typ    tokenType
+	lineno int
+	column int
+	text   string
+}
+
+func (t token) String() string {
+	switch t.typ {
+	case eof:
+		return "EOF"
+	case invalidStatement:
+		return fmt.Sprintf("invalid statement %q", t.text)
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// stateFn represents the lexer in a particular state, returning the next
+// state to transition to.
+// stateFn은 특정 상태의 렉서를 나타내며, 전환할 다음 상태를 반환합니다.
+type stateFn func(*lexer) stateFn
+
+// lexer is a lexical analyzer for the EVM assembly ("easm") language.
+// lexer는 EVM 어셈블리("easm") 언어를 위한 어휘 분석기입니다.
+type lexer struct {
+	input  string
+	tokens chan token
+	state  stateFn
+
+	lineno            int
+	lineOffset        int // byte offset in input where the current line begins
+	start, pos, width int
+
+	debug bool
+}
+
+// column returns the 1-indexed column of the token currently being scanned,
+// i.e. the position of l.start relative to the start of the current line.
+// column은 현재 스캔 중인 토큰의 1부터 시작하는 열 번호, 즉 l.start가 현재
+// 줄의 시작으로부터 떨어진 위치를 반환합니다.
+func (l *lexer) column() int {
+	return l.start - l.lineOffset + 1
+}
+
+// Lex lexes the program in the given input and returns a channel on which
+// the resulting tokens are delivered, in order, until the program has been
+// fully consumed.
+// Lex는 주어진 입력 프로그램을 어휘 분석하고, 프로그램이 전부 소비될 때까지
+// 결과 토큰을 순서대로 전달하는 채널을 반환합니다.
+func Lex(input string, debug bool) <-chan token {
+	l := &lexer{
+		input:  input,
+		tokens: make(chan token),
+		state:  lexLine,
+		lineno: 1,
+		debug:  debug,
+	}
+	go l.run()
+	return l.tokens
+}
+
+// run starts the state machine for the lexer and closes the token channel
+// once the input has been fully lexed.
+// run은 렉서를 위한 상태 머신을 시작하고, 입력이 완전히 분석되면 토큰 채널을
+// 닫습니다.
+func (l *lexer) run() {
+	for l.state != nil {
+		l.state = l.state(l)
+	}
+	close(l.tokens)
+}
+
+func (l *lexer) next() (rune rune) {
+	if l.pos >= len(l.input) {
+		l.width = 0
+		return 0
+	}
+	rune, l.width = utf8.DecodeRuneInString(l.input[l.pos:])
+	l.pos += l.width
+	return rune
+}
+
+func (l *lexer) backup() {
+	l.pos -= l.width
+}
+
+func (l *lexer) peek() rune {
+	r := l.next()
+	l.backup()
+	return r
+}
+
+func (l *lexer) ignore() {
+	l.start = l.pos
+}
+
+func (l *lexer) accept(valid string) bool {
+	if strings.ContainsRune(valid, l.next()) {
+		return true
+	}
+	l.backup()
+	return false
+}
+
+func (l *lexer) acceptRun(valid string) {
+	for strings.ContainsRune(valid, l.next()) {
+	}
+	l.backup()
+}
+
+func (l *lexer) emit(t tokenType) {
+	value := l.input[l.start:l.pos]
+	col := l.column()
+	if t == lineEnd {
+		l.lineno++
+		l.lineOffset = l.pos
+	}
+	l.tokens <- token{t, l.lineno, col, value}
+	l.start = l.pos
+}
+
+func (l *lexer) emitValue(t tokenType, value string) {
+	l.tokens <- token{t, l.lineno, l.column(), value}
+	l.start = l.pos
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) stateFn {
+	l.tokens <- token{invalidStatement, l.lineno, l.column(), fmt.Sprintf(format, args...)}
+	return nil
+}
+
+// lexLine is the entry state of the lexer; it skips whitespace and dispatches
+// to the appropriate state depending on the next rune.
+// lexLine은 렉서의 진입 상태로, 공백을 건너뛰고 다음 룬에 따라 적절한 상태로
+// 위임합니다.
+func lexLine(l *lexer) stateFn {
+	for {
+		switch r := l.next(); {
+		case r == 0:
+			l.emit(eof)
+			return nil
+		case r == '\n':
+			l.emit(lineEnd)
+			l.ignore()
+		case isSpace(r):
+			l.ignore()
+		case r == '/':
+			return lexComment
+		case r == '@':
+			return lexLabelRef
+		case r == '"':
+			return lexInsideString
+		case strings.ContainsRune(decimalNumbers, r):
+			l.backup()
+			return lexNumber
+		case strings.ContainsRune(alpha, r):
+			l.backup()
+			return lexElement
+		default:
+			return l.errorf("unrecognized character: %q", r)
+		}
+	}
+}
+
+// lexComment consumes a single-line "//" comment and discards it.
+// lexComment는 한 줄짜리 "//" 주석을 소비하고 버립니다.
+func lexComment(l *lexer) stateFn {
+	if l.peek() != '/' {
+		return l.errorf("expected second '/' to start comment")
+	}
+	for {
+		r := l.next()
+		if r == '\n' || r == 0 {
+			l.backup()
+			l.ignore()
+			return lexLine
+		}
+	}
+}
+
+// lexLabelRef lexes a "@label" reference to a label defined elsewhere in the
+// program.
+// lexLabelRef는 프로그램 다른 곳에 정의된 레이블을 가리키는 "@label" 참조를
+// 분석합니다.
+func lexLabelRef(l *lexer) stateFn {
+	l.ignore() // drop the '@'
+	l.acceptRun(alpha + decimalNumbers + "_")
+	l.emit(label)
+	return lexLine
+}
+
+// lexInsideString lexes a double-quoted string literal.
+// lexInsideString은 큰따옴표로 묶인 문자열 리터럴을 분석합니다.
+func lexInsideString(l *lexer) stateFn {
+	l.ignore() // drop the opening quote
+	for {
+		switch l.next() {
+		case '"':
+			l.backup()
+			l.emit(stringValue)
+			l.next()
+			l.ignore() // drop the closing quote
+			return lexLine
+		case 0:
+			return l.errorf("unterminated string")
+		}
+	}
+}
+
+// lexNumber lexes a decimal or "0x"-prefixed hexadecimal numeric literal.
+// lexNumber는 십진수 또는 "0x" 접두사가 붙은 16진수 숫자 리터럴을 분석합니다.
+func lexNumber(l *lexer) stateFn {
+	l.accept("0")
+	if l.accept("xX") {
+		l.acceptRun(hexNumbers)
+	} else {
+		l.acceptRun(decimalNumbers)
+	}
+	l.emit(number)
+	return lexLine
+}
+
+// lexElement lexes an identifier: either a mnemonic (e.g. "push1") or, if
+// immediately followed by ':', a label definition (e.g. "loop:").
+// lexElement는 식별자를 분석합니다: 니모닉(예: "push1")이거나, 바로 뒤에 ':'가
+// 오면 레이블 정의(예: "loop:")입니다.
+func lexElement(l *lexer) stateFn {
+	l.acceptRun(alpha + decimalNumbers + "_")
+	if l.peek() == ':' {
+		l.emit(labelDefinition)
+		l.accept(":")
+		l.ignore()
+		return lexLine
+	}
+	l.emit(element)
+	return lexLine
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r' || unicode.IsSpace(r)
+}
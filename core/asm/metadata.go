@@ -0,0 +1,119 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/asm/internal/cbor"
+)
+
+// Metadata is the decoded form of the CBOR metadata trailer that solc
+// appends to a contract's runtime bytecode.
+// Metadata는 solc가 컨트랙트 런타임 바이트코드 뒤에 덧붙이는 CBOR 메타데이터
+// 트레일러를 디코딩한 형태입니다.
+type Metadata struct {
+	IPFS         []byte
+	Bzzr1        []byte
+	Solc         string
+	Experimental bool
+
+	// Raw holds every decoded key/value pair, including ones not promoted
+	// to a dedicated field above.
+	// Raw는 위의 전용 필드로 승격되지 않은 것들을 포함하여, 디코딩된 모든
+	// 키/값 쌍을 담고 있습니다.
+	Raw map[string]interface{}
+}
+
+func (m *Metadata) String() string {
+	var parts []string
+	if m.Solc != "" {
+		parts = append(parts, "solc "+m.Solc)
+	}
+	if len(m.IPFS) > 0 {
+		parts = append(parts, "ipfs "+hex.EncodeToString(m.IPFS))
+	}
+	if len(m.Bzzr1) > 0 {
+		parts = append(parts, "bzzr1 "+hex.EncodeToString(m.Bzzr1))
+	}
+	if m.Experimental {
+		parts = append(parts, "experimental")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SplitMetadata detects the CBOR metadata trailer that solc appends to
+// runtime bytecode, and returns the code with the trailer stripped off
+// along with the decoded metadata. The trailer is identified by its last two
+// bytes, which hold the big-endian length of the CBOR blob immediately
+// preceding them.
+// SplitMetadata는 solc가 런타임 바이트코드 뒤에 덧붙이는 CBOR 메타데이터
+// 트레일러를 감지하고, 트레일러가 제거된 코드와 디코딩된 메타데이터를
+// 반환합니다. 트레일러는 마지막 두 바이트로 식별되며, 이 두 바이트는 바로
+// 앞에 있는 CBOR 블롭의 빅 엔디안 길이를 담고 있습니다.
+func SplitMetadata(code []byte) ([]byte, *Metadata, error) {
+	if len(code) < 2 {
+		return code, nil, fmt.Errorf("asm: code too short to contain a metadata trailer")
+	}
+	cborLen := int(binary.BigEndian.Uint16(code[len(code)-2:]))
+	if cborLen <= 0 || cborLen+2 > len(code) {
+		return code, nil, fmt.Errorf("asm: no metadata trailer found")
+	}
+	start := len(code) - 2 - cborLen
+
+	decoded, err := cbor.Decode(code[start : len(code)-2])
+	if err != nil {
+		return code, nil, fmt.Errorf("asm: invalid metadata trailer: %v", err)
+	}
+	raw, ok := decoded.(map[string]interface{})
+	if !ok {
+		return code, nil, fmt.Errorf("asm: metadata trailer is not a CBOR map")
+	}
+
+	meta := &Metadata{Raw: raw}
+	if v, ok := raw["ipfs"].([]byte); ok {
+		meta.IPFS = v
+	}
+	if v, ok := raw["bzzr1"].([]byte); ok {
+		meta.Bzzr1 = v
+	}
+	switch v := raw["solc"].(type) {
+	case string:
+		meta.Solc = v
+	case []byte:
+		meta.Solc = formatSolcVersion(v)
+	}
+	if v, ok := raw["experimental"].(bool); ok {
+		meta.Experimental = v
+	}
+	return code[:start], meta, nil
+}
+
+// formatSolcVersion renders solc's 3-byte <major,minor,patch> encoding of
+// its own version as a dotted version string.
+// formatSolcVersion은 solc가 자신의 버전을 <major,minor,patch> 3바이트로
+// 인코딩한 것을, 점으로 구분된 버전 문자열로 변환합니다.
+func formatSolcVersion(v []byte) string {
+	parts := make([]string, len(v))
+	for i, b := range v {
+		parts[i] = fmt.Sprintf("%d", b)
+	}
+	return strings.Join(parts, ".")
+}
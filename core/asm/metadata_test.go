@@ -0,0 +1,87 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import "testing"
+
+// solcMetadata is a minimal CBOR-encoded metadata map, {"solc": 0x000801},
+// as solc would append (without its length suffix) to runtime bytecode.
+// solcMetadata는 solc가 (길이 접미사 없이) 런타임 바이트코드 뒤에 덧붙이는
+// 것과 같은, 최소한의 CBOR로 인코딩된 메타데이터 맵 {"solc": 0x000801}
+// 입니다.
+var solcMetadata = []byte{
+	0xa1,                     // map(1)
+	0x64, 's', 'o', 'l', 'c', // text(4) "solc"
+	0x43, 0x00, 0x08, 0x01, // bytes(3) 0x000801
+}
+
+func TestSplitMetadata(t *testing.T) {
+	runtime := []byte{0x60, 0x00, 0x00} // PUSH1 0x00; STOP
+	trailer := append(append([]byte{}, solcMetadata...), 0x00, byte(len(solcMetadata)))
+	code := append(append([]byte{}, runtime...), trailer...)
+
+	gotRuntime, meta, err := SplitMetadata(code)
+	if err != nil {
+		t.Fatalf("SplitMetadata failed: %v", err)
+	}
+	if string(gotRuntime) != string(runtime) {
+		t.Errorf("runtime = %x, want %x", gotRuntime, runtime)
+	}
+	if meta.Solc != "0.8.1" {
+		t.Errorf("meta.Solc = %q, want %q", meta.Solc, "0.8.1")
+	}
+}
+
+func TestSplitMetadataOversizedLength(t *testing.T) {
+	// text(27) with an 8-byte length field claiming an absurd length. Must be
+	// rejected as a decode error, not allowed to panic while slicing.
+	hostile := []byte{0x7b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	trailer := append(append([]byte{}, hostile...), 0x00, byte(len(hostile)))
+	code := append([]byte{0x60, 0x00, 0x00}, trailer...)
+
+	if _, _, err := SplitMetadata(code); err == nil {
+		t.Fatal("SplitMetadata succeeded on an oversized CBOR length field, want an error")
+	}
+}
+
+func TestDisassembleSkipMetadata(t *testing.T) {
+	runtime := []byte{0x60, 0x00, 0x00} // PUSH1 0x00; STOP
+	trailer := append(append([]byte{}, solcMetadata...), 0x00, byte(len(solcMetadata)))
+	code := append(append([]byte{}, runtime...), trailer...)
+
+	// Without the option, the trailer is walked as if it were code.
+	plain, err := Disassemble(code)
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+
+	stripped, err := Disassemble(code, SkipMetadata())
+	if err != nil {
+		t.Fatalf("Disassemble with SkipMetadata failed: %v", err)
+	}
+	if len(stripped) >= len(plain) {
+		t.Errorf("SkipMetadata produced %d instructions, want fewer than the unstripped %d", len(stripped), len(plain))
+	}
+
+	annotated, err := Disassemble(code, AnnotateMetadata())
+	if err != nil {
+		t.Fatalf("Disassemble with AnnotateMetadata failed: %v", err)
+	}
+	if len(annotated) != len(stripped)+1 {
+		t.Fatalf("AnnotateMetadata produced %d lines, want %d (stripped + summary)", len(annotated), len(stripped)+1)
+	}
+}
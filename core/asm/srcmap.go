@@ -0,0 +1,141 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// srcMapEntry is a single decoded entry of Solidity's compressed source map,
+// one of which corresponds to a single instruction.
+// srcMapEntry는 Solidity의 압축된 소스맵을 디코딩한 단일 항목이며, 각 항목은
+// 하나의 명령어에 대응합니다.
+type srcMapEntry struct {
+	Offset   int
+	Length   int
+	FileIdx  int
+	JumpType string
+}
+
+// AnnotatedInstruction is a disassembled instruction annotated with the
+// Solidity source location it was generated from.
+// AnnotatedInstruction은 그 명령어가 생성된 Solidity 소스 위치로 주석이 달린,
+// 분해된 명령어입니다.
+type AnnotatedInstruction struct {
+	Instruction
+
+	SourceFile string `json:"sourceFile,omitempty"`
+	Offset     int    `json:"offset"`
+	Length     int    `json:"length"`
+	JumpType   string `json:"jumpType,omitempty"`
+}
+
+// parseSourceMap parses Solidity's compressed source-map format: a
+// semicolon-separated list of "s:l:f:j:m" entries, where s is the byte
+// offset into the source, l is the length, f is the source file index, j is
+// the jump type ('i' into a function, 'o' out of a function, '-' neither),
+// and m is a (here unused) modifier depth. Any field left empty inherits the
+// value of the same field from the previous entry.
+// parseSourceMap은 Solidity의 압축된 소스맵 형식을 파싱합니다: 세미콜론으로
+// 구분된 "s:l:f:j:m" 항목들의 목록으로, s는 소스 내 바이트 오프셋, l은 길이,
+// f는 소스 파일 인덱스, j는 점프 종류('i'는 함수 진입, 'o'는 함수 탈출,
+// '-'는 둘 다 아님)이며, m은 (여기서는 사용하지 않는) 수정자 깊이입니다.
+// 비어 있는 필드는 이전 항목의 동일한 필드 값을 그대로 물려받습니다.
+func parseSourceMap(srcmap string) ([]srcMapEntry, error) {
+	var (
+		entries []srcMapEntry
+		prev    srcMapEntry
+	)
+	for i, raw := range strings.Split(srcmap, ";") {
+		if raw == "" {
+			entries = append(entries, prev)
+			continue
+		}
+		fields := strings.Split(raw, ":")
+		cur := prev
+		for j, f := range fields {
+			if f == "" {
+				continue
+			}
+			switch j {
+			case 0:
+				v, err := strconv.Atoi(f)
+				if err != nil {
+					return nil, fmt.Errorf("srcmap entry %d: invalid offset %q", i, f)
+				}
+				cur.Offset = v
+			case 1:
+				v, err := strconv.Atoi(f)
+				if err != nil {
+					return nil, fmt.Errorf("srcmap entry %d: invalid length %q", i, f)
+				}
+				cur.Length = v
+			case 2:
+				v, err := strconv.Atoi(f)
+				if err != nil {
+					return nil, fmt.Errorf("srcmap entry %d: invalid file index %q", i, f)
+				}
+				cur.FileIdx = v
+			case 3:
+				cur.JumpType = f
+			}
+		}
+		entries = append(entries, cur)
+		prev = cur
+	}
+	return entries, nil
+}
+
+// DisassembleWithSourceMap disassembles code and attaches to every
+// instruction the source location it was compiled from, according to
+// Solidity's srcmap and the sources it references. sources maps a source-map
+// file index to the corresponding Solidity file path. Instructions past the
+// last srcmap entry are left unannotated; an entry whose file index is not
+// in sources still sets Offset, Length and JumpType but leaves SourceFile
+// empty.
+// DisassembleWithSourceMap은 code를 분해하고, Solidity의 srcmap과 그것이
+// 참조하는 sources에 따라 각 명령어에 그 명령어가 컴파일된 소스 위치를
+// 붙입니다. sources는 소스맵 파일 인덱스를 해당 Solidity 파일 경로에
+// 매핑합니다. 마지막 srcmap 항목을 지난 명령어들은 주석이 달리지 않은 채로
+// 남습니다; 파일 인덱스가 sources에 없는 항목은 Offset, Length, JumpType은
+// 설정하지만 SourceFile은 비워 둡니다.
+func DisassembleWithSourceMap(code []byte, srcmap string, sources map[int]string) ([]AnnotatedInstruction, error) {
+	entries, err := parseSourceMap(srcmap)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []AnnotatedInstruction
+	it := NewInstructionIterator(code)
+	for i := 0; it.Next(); i++ {
+		ai := AnnotatedInstruction{Instruction: toInstruction(it)}
+		if i < len(entries) {
+			e := entries[i]
+			ai.Offset = e.Offset
+			ai.Length = e.Length
+			ai.JumpType = e.JumpType
+			ai.SourceFile = sources[e.FileIdx]
+		}
+		out = append(out, ai)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2017 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package asm
+
+import "testing"
+
+func TestParseSourceMap(t *testing.T) {
+	// Entry 0 is fully specified; entry 1 omits every field and must
+	// inherit entry 0's values; entry 2 changes the offset, length and jump
+	// type.
+	entries, err := parseSourceMap("0:10:0:-;;20:5:0:i")
+	if err != nil {
+		t.Fatalf("parseSourceMap failed: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	if entries[0] != (srcMapEntry{Offset: 0, Length: 10, FileIdx: 0, JumpType: "-"}) {
+		t.Errorf("entry 0 = %+v", entries[0])
+	}
+	if entries[1] != entries[0] {
+		t.Errorf("entry 1 = %+v, want it to inherit entry 0 = %+v", entries[1], entries[0])
+	}
+	if entries[2] != (srcMapEntry{Offset: 20, Length: 5, FileIdx: 0, JumpType: "i"}) {
+		t.Errorf("entry 2 = %+v", entries[2])
+	}
+}
+
+func TestDisassembleWithSourceMap(t *testing.T) {
+	// PUSH1 0x00; STOP
+	script := []byte{0x60, 0x00, 0x00}
+	srcmap := "0:4:0:-;5:4:0:-"
+	sources := map[int]string{0: "contracts/A.sol"}
+
+	instrs, err := DisassembleWithSourceMap(script, srcmap, sources)
+	if err != nil {
+		t.Fatalf("DisassembleWithSourceMap failed: %v", err)
+	}
+	if len(instrs) != 2 {
+		t.Fatalf("got %d instructions, want 2", len(instrs))
+	}
+	if instrs[0].SourceFile != "contracts/A.sol" || instrs[0].Offset != 0 || instrs[0].Length != 4 {
+		t.Errorf("unexpected first instruction: %+v", instrs[0])
+	}
+	if instrs[1].Offset != 5 {
+		t.Errorf("unexpected second instruction: %+v", instrs[1])
+	}
+}